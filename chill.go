@@ -19,6 +19,11 @@ chillson's Son type methods, like in the example below.
     intField, err := chill.GetInt("[gophers][0][weight]")
     fmt.Println(intField)
 
+    // paths starting with "/" are read as RFC 6901 JSON Pointers instead, for interop with
+    // JSON Schema, JSON Patch and OpenAPI tooling:
+    strField, err = chill.GetStr("/gophers/0/name")
+    fmt.Println(strField)
+
     // you can also spawn "smaller" Son{}'s to avoid some underlying type assertions:
     gophersTable, err := chill.GetArr("[gophers]")
     for i := 0; i < len(gophersTable); i++ {
@@ -45,6 +50,8 @@ chillson's Son type methods, like in the example below.
         ...
     case NullLeaf:        // "Chillson: null leaf encountered in the structure"
         ...
+    case NonStringKey:    // "Chillson: encountered a map key that is not a string, chillson can only walk string-keyed maps."
+        ...
     }
 
 Chillson is MIT-licensed (see LICENSE). Pull requests, general suggestions (also regarding quality of documentation) and filing issues
@@ -53,6 +60,8 @@ are welcome.
 package chillson
 
 import (
+	"encoding/json"
+	"math/big"
 	"regexp"
 	"strconv"
 	"strings"
@@ -67,6 +76,7 @@ const (
 	FieldNotFound
 	BadValueType
 	NullLeaf
+	NonStringKey
 )
 
 func (err chillsonErr) Error() string {
@@ -83,6 +93,8 @@ func (err chillsonErr) Error() string {
 		return "Chillson: retrieved value cannot be converted to the requested type."
 	case NullLeaf:
 		return "Chillson: null leaf encountered in the structure"
+	case NonStringKey:
+		return "Chillson: encountered a map key that is not a string, chillson can only walk string-keyed maps."
 	}
 	return "Undefined Chillson error."
 }
@@ -92,21 +104,70 @@ type Son struct {
 	Data (interface{})
 }
 
-/* Get() returns value from given location in Son data. Object keys and array indices should be both enclosed in
-[square brackets], WITHOUT "quotation marks". String indices (= object keys) can be arbitrary JSON strings as in
-JSON source, but they shouldn't contain square brackets [ ]. */
-func (c *Son) Get(path string) (interface{}, error) {
+/* NewFromDecoder reads the next top-level JSON value off dec into a Son, switching dec into
+json.Number mode first so integers are preserved losslessly instead of being decoded through
+float64 - which silently loses precision above 2^53, the sort of thing that corrupts Snowflake- or
+Twitter-style int64 IDs. Pair it with GetInt64/GetUint64/GetNumber to read such values back without
+truncation; GetFloat/GetInt still work as before, since they also accept json.Number leaves. */
+func NewFromDecoder(dec *json.Decoder) (*Son, error) {
+	dec.UseNumber()
+	var data interface{}
+	if err := dec.Decode(&data); err != nil {
+		return nil, err
+	}
+	return &Son{data}, nil
+}
+
+/* pathTokens splits a Get-style path into its individual labels. A path starting with "/" is
+treated as an RFC 6901 JSON Pointer and split accordingly; otherwise the legacy [bracket][form]
+grammar is used. An empty path yields no tokens, meaning "the whole document" in both modes. */
+func pathTokens(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if strings.HasPrefix(path, "/") {
+		return parsePointerTokens(path), nil
+	}
 	format := regexp.MustCompile("(?:\\[([^\\[\\]]+)\\])+?")
 	matches := format.FindAllString(path, -1)
-	if len(matches) == 0 && len(path) != 0 {
+	if len(matches) == 0 {
 		return nil, InvalidPath
 	}
+	tokens := make([]string, len(matches))
+	for i, label := range matches {
+		tokens[i] = strings.Trim(label, "[]")
+	}
+	return tokens, nil
+}
+
+/* parsePointerTokens splits an RFC 6901 JSON Pointer such as "/gophers/0/name" into its raw
+tokens, unescaping "~1" to "/" and "~0" to "~" in each one, in that order as mandated by the RFC. */
+func parsePointerTokens(pointer string) []string {
+	rawTokens := strings.Split(pointer, "/")[1:]
+	tokens := make([]string, len(rawTokens))
+	for i, tok := range rawTokens {
+		tok = strings.Replace(tok, "~1", "/", -1)
+		tok = strings.Replace(tok, "~0", "~", -1)
+		tokens[i] = tok
+	}
+	return tokens
+}
+
+/* Get() returns value from given location in Son data. Object keys and array indices should be both enclosed in
+[square brackets], WITHOUT "quotation marks". String indices (= object keys) can be arbitrary JSON strings as in
+JSON source, but they shouldn't contain square brackets [ ]. Alternatively, a path starting with "/" is read as an
+RFC 6901 JSON Pointer (e.g. "/gophers/0/name"), which is the syntax used by JSON Schema $ref, JSON Patch and
+OpenAPI tooling; GetP below rejects the bracket form if you want to enforce that format explicitly. */
+func (c *Son) Get(path string) (interface{}, error) {
+	tokens, err := pathTokens(path)
+	if err != nil {
+		return nil, err
+	}
 	var currLeaf *(interface{}) = &(*c).Data
-	for _, label := range matches {
+	for _, label := range tokens {
 		if currLeaf == nil {
 			return nil, NullLeaf
 		}
-		label = strings.Trim(label, "[]")
 		// If label is parse'able to integer, try to convert the parent to JSON array (= go slice).
 		if numIndex, err := strconv.Atoi(label); err == nil {
 			leafArr, ok := (*currLeaf).([]interface{})
@@ -132,6 +193,17 @@ func (c *Son) Get(path string) (interface{}, error) {
 	return *currLeaf, nil
 }
 
+/* GetP behaves like Get, but only accepts RFC 6901 JSON Pointer paths (e.g. "/gophers/0/name"),
+returning InvalidPath for anything else. Use it when the path comes from a source that guarantees
+pointer syntax, such as a JSON Schema $ref or a JSON Patch document, and you want to fail fast on
+a mismatched format rather than silently falling back to bracket parsing. */
+func (c *Son) GetP(path string) (interface{}, error) {
+	if path != "" && !strings.HasPrefix(path, "/") {
+		return nil, InvalidPath
+	}
+	return (*c).Get(path)
+}
+
 func (c *Son) GetArr(path string) ([]interface{}, error) {
 	val, err := (*c).Get(path)
 	if err != nil {
@@ -161,11 +233,17 @@ func (c *Son) GetFloat(path string) (float64, error) {
 	if err != nil {
 		return -1, err
 	}
-	num, ok := val.(float64)
-	if !ok {
-		return -1, BadValueType
+	switch num := val.(type) {
+	case float64:
+		return num, nil
+	case json.Number:
+		f, err := num.Float64()
+		if err != nil {
+			return -1, BadValueType
+		}
+		return f, nil
 	}
-	return num, nil
+	return -1, BadValueType
 }
 
 func (c *Son) GetInt(path string) (int, error) {
@@ -176,6 +254,60 @@ func (c *Son) GetInt(path string) (int, error) {
 	return int(num), nil
 }
 
+/* GetNumber returns the value at path as a json.Number, whether the underlying leaf is a
+json.Number (from a Son built via NewFromDecoder) or a plain float64; it's the lossless building
+block GetInt64/GetUint64 are implemented on top of. */
+func (c *Son) GetNumber(path string) (json.Number, error) {
+	val, err := (*c).Get(path)
+	if err != nil {
+		return "", err
+	}
+	switch num := val.(type) {
+	case json.Number:
+		return num, nil
+	case float64:
+		return json.Number(strconv.FormatFloat(num, 'f', -1, 64)), nil
+	}
+	return "", BadValueType
+}
+
+/* GetInt64 returns the value at path as an int64 without routing through float64, so integers
+above 2^53 (the float64 mantissa limit) aren't silently corrupted; it returns BadValueType only
+when the underlying token genuinely can't be represented as an int64, e.g. it has a fractional part
+or overflows. */
+func (c *Son) GetInt64(path string) (int64, error) {
+	num, err := (*c).GetNumber(path)
+	if err != nil {
+		return -1, err
+	}
+	if i, err := num.Int64(); err == nil {
+		return i, nil
+	}
+	// Integral values written with a fraction or an exponent ("12.0", "1e3") are parsed exactly.
+	r, ok := new(big.Rat).SetString(num.String())
+	if !ok || !r.IsInt() || !r.Num().IsInt64() {
+		return -1, BadValueType
+	}
+	return r.Num().Int64(), nil
+}
+
+/* GetUint64 behaves like GetInt64, but for the unsigned range; negative values and anything that
+doesn't fit in a uint64 return BadValueType. */
+func (c *Son) GetUint64(path string) (uint64, error) {
+	num, err := (*c).GetNumber(path)
+	if err != nil {
+		return 0, err
+	}
+	if u, err := strconv.ParseUint(num.String(), 10, 64); err == nil {
+		return u, nil
+	}
+	r, ok := new(big.Rat).SetString(num.String())
+	if !ok || !r.IsInt() || !r.Num().IsUint64() {
+		return 0, BadValueType
+	}
+	return r.Num().Uint64(), nil
+}
+
 func (c *Son) GetStr(path string) (string, error) {
 	val, err := (*c).Get(path)
 	if err != nil {
@@ -200,6 +332,218 @@ func (c *Son) GetObj(path string) (map[string]interface{}, error) {
 	return obj, nil
 }
 
+/* fetchFromContainer reads label out of container, which must be either a map[string]interface{}
+or, for a numeric label, a []interface{}; it's the shared lookup used by Query's child steps and
+the mutation helpers below so they agree on what "ParentNotObject"/"OutOfRange"/"FieldNotFound"
+mean. Get itself walks the path with its own loop and does not call it. */
+func fetchFromContainer(container interface{}, label string) (interface{}, error) {
+	if container == nil {
+		return nil, NullLeaf
+	}
+	if numIndex, err := strconv.Atoi(label); err == nil {
+		if arr, ok := container.([]interface{}); ok {
+			if numIndex < 0 || numIndex >= len(arr) {
+				return nil, OutOfRange
+			}
+			return arr[numIndex], nil
+		}
+	}
+	obj, ok := container.(map[string]interface{})
+	if !ok {
+		return nil, ParentNotObject
+	}
+	val, found := obj[label]
+	if !found {
+		return nil, FieldNotFound
+	}
+	return val, nil
+}
+
+/* assignToContainer writes value under label directly into container's backing map or slice, so
+the write is visible to whoever already holds a reference to that map or slice - unlike indexing
+through a loop-local copy, which is what keeps Get() read-only. */
+func assignToContainer(container interface{}, label string, value interface{}) error {
+	if numIndex, err := strconv.Atoi(label); err == nil {
+		if arr, ok := container.([]interface{}); ok {
+			if numIndex < 0 || numIndex >= len(arr) {
+				return OutOfRange
+			}
+			arr[numIndex] = value
+			return nil
+		}
+	}
+	obj, ok := container.(map[string]interface{})
+	if !ok {
+		return ParentNotObject
+	}
+	obj[label] = value
+	return nil
+}
+
+/* containerAt walks every token fully (unlike walkToParent, which stops one token short) and
+returns the JSON object or array found there; an empty token list returns Son's own Data. */
+func (c *Son) containerAt(tokens []string) (interface{}, error) {
+	var container interface{} = (*c).Data
+	for _, label := range tokens {
+		next, err := fetchFromContainer(container, label)
+		if err != nil {
+			return nil, err
+		}
+		container = next
+	}
+	return container, nil
+}
+
+/* walkToParent parses path and walks all but its last token, returning the container (JSON object
+or array) that holds the final token together with that token itself. When createMissing is true,
+a missing object key along the way is created as an empty JSON object; array indices are never
+auto-created, since growing an array takes a real append (see ArrayAppend/ArrayConcat). */
+func (c *Son) walkToParent(path string, createMissing bool) (interface{}, string, error) {
+	tokens, err := pathTokens(path)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(tokens) == 0 {
+		return nil, "", InvalidPath
+	}
+	var container interface{} = (*c).Data
+	for _, label := range tokens[:len(tokens)-1] {
+		next, err := fetchFromContainer(container, label)
+		if err != nil {
+			if err == FieldNotFound && createMissing {
+				if obj, ok := container.(map[string]interface{}); ok {
+					next = map[string]interface{}{}
+					obj[label] = next
+				} else {
+					return nil, "", err
+				}
+			} else {
+				return nil, "", err
+			}
+		}
+		container = next
+	}
+	return container, tokens[len(tokens)-1], nil
+}
+
+/* Set writes value at path, overwriting whatever was there, and auto-creates any missing
+intermediate JSON objects along the way. Writing to "" replaces Son's entire Data. It cannot
+auto-create array elements: writing to an out-of-range index still returns OutOfRange, and
+ArrayAppend/ArrayConcat are the way to grow an array. */
+func (c *Son) Set(value interface{}, path string) error {
+	return c.setAt(value, path, true)
+}
+
+/* SetStrict behaves like Set, but returns FieldNotFound instead of silently creating missing
+intermediate objects; use it when path is expected to already exist. */
+func (c *Son) SetStrict(value interface{}, path string) error {
+	return c.setAt(value, path, false)
+}
+
+func (c *Son) setAt(value interface{}, path string, createMissing bool) error {
+	if path == "" {
+		(*c).Data = value
+		return nil
+	}
+	container, lastLabel, err := c.walkToParent(path, createMissing)
+	if err != nil {
+		return err
+	}
+	return assignToContainer(container, lastLabel, value)
+}
+
+/* SetIndex writes value at the given index of Son's own Data, which must already be a JSON array
+(e.g. a Son spawned from GetArr); it's a shorthand for Set when you're already holding such a
+"smaller" Son and addressing it by index directly is more convenient than building a bracket
+path. */
+func (c *Son) SetIndex(value interface{}, index int) error {
+	arr, ok := (*c).Data.([]interface{})
+	if !ok {
+		return ParentNotObject
+	}
+	if index < 0 || index >= len(arr) {
+		return OutOfRange
+	}
+	arr[index] = value
+	return nil
+}
+
+/* ArrayAppend appends value to the JSON array at path, creating it (and any missing intermediate
+objects) as an empty array first if nothing is there yet. */
+func (c *Son) ArrayAppend(value interface{}, path string) error {
+	return c.arrayConcatAt([]interface{}{value}, path, true)
+}
+
+/* ArrayConcat appends every element of values, in order, to the JSON array at path; see
+ArrayAppend for the single-value case. */
+func (c *Son) ArrayConcat(values []interface{}, path string) error {
+	return c.arrayConcatAt(values, path, true)
+}
+
+func (c *Son) arrayConcatAt(values []interface{}, path string, createMissing bool) error {
+	container, lastLabel, err := c.walkToParent(path, createMissing)
+	if err != nil {
+		return err
+	}
+	current, err := fetchFromContainer(container, lastLabel)
+	if err != nil {
+		if err != FieldNotFound || !createMissing {
+			return err
+		}
+		current = []interface{}{}
+	}
+	arr, ok := current.([]interface{})
+	if !ok {
+		return BadValueType
+	}
+	return assignToContainer(container, lastLabel, append(arr, values...))
+}
+
+/* Delete removes the value at path: a map key is simply deleted, while a JSON array element is
+removed and the later elements shift down, shrinking the array by one - the same result a single
+append(arr[:i], arr[i+1:]...) would give. */
+func (c *Son) Delete(path string) error {
+	tokens, err := pathTokens(path)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return InvalidPath
+	}
+	lastLabel := tokens[len(tokens)-1]
+	parentTokens := tokens[:len(tokens)-1]
+	parent, err := c.containerAt(parentTokens)
+	if err != nil {
+		return err
+	}
+	if numIndex, convErr := strconv.Atoi(lastLabel); convErr == nil {
+		if arr, ok := parent.([]interface{}); ok {
+			if numIndex < 0 || numIndex >= len(arr) {
+				return OutOfRange
+			}
+			shrunk := append(arr[:numIndex:numIndex], arr[numIndex+1:]...)
+			if len(parentTokens) == 0 {
+				(*c).Data = shrunk
+				return nil
+			}
+			grandparent, err := c.containerAt(parentTokens[:len(parentTokens)-1])
+			if err != nil {
+				return err
+			}
+			return assignToContainer(grandparent, parentTokens[len(parentTokens)-1], shrunk)
+		}
+	}
+	obj, ok := parent.(map[string]interface{})
+	if !ok {
+		return ParentNotObject
+	}
+	if _, found := obj[lastLabel]; !found {
+		return FieldNotFound
+	}
+	delete(obj, lastLabel)
+	return nil
+}
+
 func (c *Son) Require(path string) interface{} {
 	ret, err := c.Get(path)
 	if err != nil {
@@ -240,6 +584,30 @@ func (c *Son) RequireInt(path string) int {
 	return ret
 }
 
+func (c *Son) RequireInt64(path string) int64 {
+	ret, err := c.GetInt64(path)
+	if err != nil {
+		panic(err.Error())
+	}
+	return ret
+}
+
+func (c *Son) RequireUint64(path string) uint64 {
+	ret, err := c.GetUint64(path)
+	if err != nil {
+		panic(err.Error())
+	}
+	return ret
+}
+
+func (c *Son) RequireNumber(path string) json.Number {
+	ret, err := c.GetNumber(path)
+	if err != nil {
+		panic(err.Error())
+	}
+	return ret
+}
+
 func (c *Son) RequireObj(path string) map[string]interface{} {
 	ret, err := c.GetObj(path)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
     "reflect"
+	"strings"
 	"testing"
 )
 
@@ -88,3 +89,138 @@ func TestGetTyped(t *testing.T) {
         t.Errorf(fmt.Sprintf("[3] on array object doesn't return bool false when requested (%v of kind %v).", b, reflect.ValueOf(b).Kind()))
     }
 }
+
+func TestGetPointer(t *testing.T) {
+	sampleJSON := "{\"foo\":\"bar\",\"a/b\":\"slash\",\"arr\":[\"joe\", \"mary\"]}"
+	var data interface{}
+	err := json.Unmarshal([]byte(sampleJSON), &data)
+	if err != nil {
+		t.Errorf(err.Error())
+		return
+	}
+	chill := Son{data}
+	all, err := chill.Get("")
+	if _, ok := all.(map[string]interface{}); err != nil || !ok {
+		t.Errorf("Empty path doesn't return the entire JSON object.")
+	}
+	c1, err := chill.Get("/foo")
+	if err != nil || c1 != "bar" {
+		t.Errorf("/foo doesn't return \"bar\" (%v).", c1)
+	}
+	c2, err := chill.Get("/arr/1")
+	if err != nil || c2 != "mary" {
+		t.Errorf("/arr/1 doesn't return \"mary\" (%v).", c2)
+	}
+	c3, err := chill.Get("/a~1b")
+	if err != nil || c3 != "slash" {
+		t.Errorf("/a~1b doesn't unescape to \"a/b\" (%v).", c3)
+	}
+	if _, err := chill.GetP("[foo]"); err != InvalidPath {
+		t.Errorf("GetP should reject bracket-style paths with InvalidPath.")
+	}
+	s, err := chill.GetP("/foo")
+	if err != nil || s != "bar" {
+		t.Errorf("GetP(\"/foo\") doesn't return \"bar\" (%v).", s)
+	}
+}
+
+func TestMutate(t *testing.T) {
+	sampleJSON := "{\"foo\":\"bar\",\"arr\":[\"joe\", \"mary\"]}"
+	var data interface{}
+	err := json.Unmarshal([]byte(sampleJSON), &data)
+	if err != nil {
+		t.Errorf(err.Error())
+		return
+	}
+	chill := Son{data}
+
+	if err := chill.Set("baz", "[foo]"); err != nil {
+		t.Errorf("Set on an existing key failed: %v", err)
+	}
+	if v, err := chill.GetStr("[foo]"); err != nil || v != "baz" {
+		t.Errorf("Set on [foo] didn't stick (%v, %v).", v, err)
+	}
+
+	if err := chill.Set(42.0, "[gophers][0][weight]"); err != nil {
+		t.Errorf("Set with auto-created intermediates failed: %v", err)
+	}
+	if v, err := chill.GetInt("[gophers][0][weight]"); err != nil || v != 42 {
+		t.Errorf("Set didn't auto-create missing intermediate objects (%v, %v).", v, err)
+	}
+	if err := chill.SetStrict(43.0, "[gophers][0][weight]"); err != nil {
+		t.Errorf("SetStrict on an existing path failed: %v", err)
+	}
+	if err := chill.SetStrict(1.0, "[gophers][1][weight]"); err != FieldNotFound {
+		t.Errorf("SetStrict should refuse to auto-create missing intermediates, got %v", err)
+	}
+
+	if err := chill.SetIndex("joanna", 1); err != ParentNotObject {
+		t.Errorf("SetIndex on a non-array Son's Data should return ParentNotObject, got %v", err)
+	}
+	gophersArr, err := chill.GetArr("[arr]")
+	if err != nil {
+		t.Errorf("GetArr([arr]) failed: %v", err)
+	}
+	gophersChill := Son{gophersArr}
+	if err := gophersChill.SetIndex("joanna", 1); err != nil {
+		t.Errorf("SetIndex on array Data failed: %v", err)
+	}
+	if v, err := chill.GetStr("[arr][1]"); err != nil || v != "joanna" {
+		t.Errorf("SetIndex on a spawned Son didn't alias back into the parent array (%v, %v).", v, err)
+	}
+
+	if err := chill.ArrayAppend("sue", "[arr]"); err != nil {
+		t.Errorf("ArrayAppend failed: %v", err)
+	}
+	if v, err := chill.GetStr("[arr][2]"); err != nil || v != "sue" {
+		t.Errorf("ArrayAppend didn't stick (%v, %v).", v, err)
+	}
+	if err := chill.ArrayConcat([]interface{}{"ann", "bob"}, "[newarr]"); err != nil {
+		t.Errorf("ArrayConcat onto a missing path failed: %v", err)
+	}
+	if v, err := chill.GetStr("[newarr][1]"); err != nil || v != "bob" {
+		t.Errorf("ArrayConcat didn't auto-create the array (%v, %v).", v, err)
+	}
+
+	if err := chill.Delete("[arr][0]"); err != nil {
+		t.Errorf("Delete of an array element failed: %v", err)
+	}
+	if v, err := chill.GetStr("[arr][0]"); err != nil || v != "joanna" {
+		t.Errorf("Delete of [arr][0] didn't shift the later elements down (%v, %v).", v, err)
+	}
+	if err := chill.Delete("[foo]"); err != nil {
+		t.Errorf("Delete of an object key failed: %v", err)
+	}
+	if _, err := chill.Get("[foo]"); err != FieldNotFound {
+		t.Errorf("Delete of [foo] didn't remove the key, got %v", err)
+	}
+}
+
+func TestGetNumber(t *testing.T) {
+	sampleJSON := "{\"id\":9223372036854775807,\"price\":12.5}"
+	dec := json.NewDecoder(strings.NewReader(sampleJSON))
+	chill, err := NewFromDecoder(dec)
+	if err != nil {
+		t.Errorf(err.Error())
+		return
+	}
+	id, err := chill.GetInt64("[id]")
+	if err != nil || id != 9223372036854775807 {
+		t.Errorf("GetInt64 didn't losslessly return the int64 max value (%v, %v).", id, err)
+	}
+	uid, err := chill.GetUint64("[id]")
+	if err != nil || uid != 9223372036854775807 {
+		t.Errorf("GetUint64 didn't losslessly return the large id (%v, %v).", uid, err)
+	}
+	price, err := chill.GetFloat("[price]")
+	if err != nil || price != 12.5 {
+		t.Errorf("GetFloat on a json.Number leaf failed (%v, %v).", price, err)
+	}
+	if _, err := chill.GetInt64("[price]"); err != BadValueType {
+		t.Errorf("GetInt64 on a fractional number should return BadValueType, got %v", err)
+	}
+	num, err := chill.GetNumber("[price]")
+	if err != nil || num.String() != "12.5" {
+		t.Errorf("GetNumber didn't return the raw json.Number (%v, %v).", num, err)
+	}
+}
@@ -0,0 +1,388 @@
+package chillson
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/* Result bundles a value matched by Query with the concrete bracket path it was found at, so
+callers can round-trip a match through Get/Require or the mutation API. */
+type Result struct {
+	Value interface{}
+	Path  string
+}
+
+type queryStepKind int
+
+const (
+	queryChild queryStepKind = iota
+	queryWildcard
+	queryRecursive
+	querySlice
+	queryFilter
+)
+
+type queryStep struct {
+	kind  queryStepKind
+	label string // queryChild: the object key or array index to match; queryRecursive: the object key
+
+	sliceStart, sliceEnd, sliceStep int
+	sliceHasStart, sliceHasEnd      bool
+
+	filterField string
+	filterOp    string
+	filterValue interface{}
+}
+
+type queryMatch struct {
+	value interface{}
+	path  string
+}
+
+var queryIdentRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*`)
+
+/* Query runs a small JSONPath-like expr against Data and returns every match, each carrying the
+bracket path it was found at. Supported syntax: "[name]"/".name" child access, "[*]" wildcard over
+both arrays and objects, "..name" recursive descent, "[start:end:step]" array slices (Python-style,
+any part may be omitted; a step of 0 is treated as 1), and "[?(@.field>10)]"/"[?(@.field==\"joe\")]" filter predicates over an
+array of objects, using ==, !=, >, >=, < or <=. A leading "$" is accepted and ignored. */
+func (c *Son) Query(expr string) ([]Result, error) {
+	steps, err := parseQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	matches := []queryMatch{{value: (*c).Data, path: ""}}
+	for _, step := range steps {
+		var next []queryMatch
+		for _, m := range matches {
+			next = append(next, applyQueryStep(step, m)...)
+		}
+		matches = next
+	}
+	results := make([]Result, len(matches))
+	for i, m := range matches {
+		results[i] = Result{Value: m.value, Path: m.path}
+	}
+	return results, nil
+}
+
+func parseQuery(expr string) ([]queryStep, error) {
+	expr = strings.TrimPrefix(expr, "$")
+	var steps []queryStep
+	for len(expr) > 0 {
+		switch {
+		case strings.HasPrefix(expr, ".."):
+			rest := expr[2:]
+			name := queryIdentRe.FindString(rest)
+			if name == "" {
+				return nil, InvalidPath
+			}
+			steps = append(steps, queryStep{kind: queryRecursive, label: name})
+			expr = rest[len(name):]
+		case strings.HasPrefix(expr, "."):
+			rest := expr[1:]
+			name := queryIdentRe.FindString(rest)
+			if name == "" {
+				return nil, InvalidPath
+			}
+			steps = append(steps, queryStep{kind: queryChild, label: name})
+			expr = rest[len(name):]
+		case strings.HasPrefix(expr, "[*]"):
+			steps = append(steps, queryStep{kind: queryWildcard})
+			expr = expr[len("[*]"):]
+		case strings.HasPrefix(expr, "[?("):
+			end := strings.Index(expr, ")]")
+			if end == -1 {
+				return nil, InvalidPath
+			}
+			step, err := parseQueryFilter(expr[len("[?(") : end])
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, step)
+			expr = expr[end+len(")]"):]
+		case strings.HasPrefix(expr, "["):
+			end := strings.Index(expr, "]")
+			if end == -1 {
+				return nil, InvalidPath
+			}
+			inner := expr[1:end]
+			if strings.Contains(inner, ":") {
+				step, err := parseQuerySlice(inner)
+				if err != nil {
+					return nil, err
+				}
+				steps = append(steps, step)
+			} else {
+				steps = append(steps, queryStep{kind: queryChild, label: inner})
+			}
+			expr = expr[end+1:]
+		default:
+			return nil, InvalidPath
+		}
+	}
+	return steps, nil
+}
+
+func parseQuerySlice(inner string) (queryStep, error) {
+	parts := strings.Split(inner, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return queryStep{}, InvalidPath
+	}
+	step := queryStep{kind: querySlice, sliceStep: 1}
+	if parts[0] != "" {
+		v, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return queryStep{}, InvalidPath
+		}
+		step.sliceStart, step.sliceHasStart = v, true
+	}
+	if parts[1] != "" {
+		v, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return queryStep{}, InvalidPath
+		}
+		step.sliceEnd, step.sliceHasEnd = v, true
+	}
+	if len(parts) == 3 && parts[2] != "" {
+		v, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return queryStep{}, InvalidPath
+		}
+		step.sliceStep = v
+	}
+	return step, nil
+}
+
+// comparison operators, checked longest-first so ">=" isn't cut short by ">".
+var queryFilterOps = []string{"==", "!=", ">=", "<=", ">", "<"}
+
+func parseQueryFilter(inner string) (queryStep, error) {
+	if !strings.HasPrefix(inner, "@.") {
+		return queryStep{}, InvalidPath
+	}
+	inner = inner[len("@."):]
+	for _, op := range queryFilterOps {
+		if idx := strings.Index(inner, op); idx >= 0 {
+			field := strings.TrimSpace(inner[:idx])
+			value := parseQueryFilterValue(strings.TrimSpace(inner[idx+len(op):]))
+			return queryStep{kind: queryFilter, filterField: field, filterOp: op, filterValue: value}, nil
+		}
+	}
+	return queryStep{}, InvalidPath
+}
+
+func parseQueryFilterValue(raw string) interface{} {
+	if len(raw) >= 2 && raw[0] == '"' && raw[len(raw)-1] == '"' {
+		return raw[1 : len(raw)-1]
+	}
+	if num, err := strconv.ParseFloat(raw, 64); err == nil {
+		return num
+	}
+	switch raw {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	return raw
+}
+
+func applyQueryStep(step queryStep, m queryMatch) []queryMatch {
+	switch step.kind {
+	case queryChild:
+		return applyQueryChild(step.label, m)
+	case queryWildcard:
+		return applyQueryWildcard(m)
+	case queryRecursive:
+		return applyQueryRecursive(step.label, m)
+	case querySlice:
+		return applyQuerySlice(step, m)
+	case queryFilter:
+		return applyQueryFilter(step, m)
+	}
+	return nil
+}
+
+func applyQueryChild(label string, m queryMatch) []queryMatch {
+	next, err := fetchFromContainer(m.value, label)
+	if err != nil {
+		return nil
+	}
+	return []queryMatch{{value: next, path: m.path + "[" + label + "]"}}
+}
+
+func applyQueryWildcard(m queryMatch) []queryMatch {
+	switch v := m.value.(type) {
+	case []interface{}:
+		out := make([]queryMatch, len(v))
+		for i, e := range v {
+			out[i] = queryMatch{value: e, path: fmt.Sprintf("%s[%d]", m.path, i)}
+		}
+		return out
+	case map[string]interface{}:
+		return sortedChildren(v, m.path)
+	}
+	return nil
+}
+
+func applyQueryRecursive(name string, m queryMatch) []queryMatch {
+	var out []queryMatch
+	var walk func(value interface{}, path string)
+	walk = func(value interface{}, path string) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for _, child := range sortedChildren(v, path) {
+				if strings.HasSuffix(child.path, "["+name+"]") {
+					out = append(out, child)
+				}
+				walk(child.value, child.path)
+			}
+		case []interface{}:
+			for i, e := range v {
+				walk(e, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	}
+	walk(m.value, m.path)
+	return out
+}
+
+// sortedChildren lists an object's own children in alphabetical key order, so wildcard and
+// recursive-descent matches come back in a deterministic order despite Go's map iteration.
+func sortedChildren(obj map[string]interface{}, path string) []queryMatch {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]queryMatch, len(keys))
+	for i, k := range keys {
+		out[i] = queryMatch{value: obj[k], path: path + "[" + k + "]"}
+	}
+	return out
+}
+
+func applyQuerySlice(step queryStep, m queryMatch) []queryMatch {
+	arr, ok := m.value.([]interface{})
+	if !ok {
+		return nil
+	}
+	n := len(arr)
+	strideVal := step.sliceStep
+	if strideVal == 0 {
+		strideVal = 1
+	}
+	start, end := 0, n
+	lo, hi := 0, n
+	if strideVal < 0 {
+		start, end = n-1, -1
+		lo, hi = -1, n-1
+	}
+	if step.sliceHasStart {
+		start = normalizeSliceIndex(step.sliceStart, n, lo, hi)
+	}
+	if step.sliceHasEnd {
+		end = normalizeSliceIndex(step.sliceEnd, n, lo, hi)
+	}
+	var out []queryMatch
+	if strideVal > 0 {
+		for i := start; i < end && i < n; i += strideVal {
+			if i >= 0 {
+				out = append(out, queryMatch{value: arr[i], path: fmt.Sprintf("%s[%d]", m.path, i)})
+			}
+		}
+	} else {
+		for i := start; i > end && i >= 0; i += strideVal {
+			if i < n {
+				out = append(out, queryMatch{value: arr[i], path: fmt.Sprintf("%s[%d]", m.path, i)})
+			}
+		}
+	}
+	return out
+}
+
+func normalizeSliceIndex(i, n, lo, hi int) int {
+	if i < 0 {
+		i += n
+	}
+	if i < lo {
+		i = lo
+	}
+	if i > hi {
+		i = hi
+	}
+	return i
+}
+
+func applyQueryFilter(step queryStep, m queryMatch) []queryMatch {
+	arr, ok := m.value.([]interface{})
+	if !ok {
+		return nil
+	}
+	var out []queryMatch
+	for i, e := range arr {
+		obj, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fieldVal, found := obj[step.filterField]
+		if !found {
+			continue
+		}
+		if queryFilterMatches(fieldVal, step.filterOp, step.filterValue) {
+			out = append(out, queryMatch{value: e, path: fmt.Sprintf("%s[%d]", m.path, i)})
+		}
+	}
+	return out
+}
+
+func queryFilterMatches(fieldVal interface{}, op string, target interface{}) bool {
+	if op == "==" || op == "!=" {
+		equal := queryValuesEqual(fieldVal, target)
+		if op == "!=" {
+			return !equal
+		}
+		return equal
+	}
+	fNum, fOk := queryToFloat(fieldVal)
+	tNum, tOk := queryToFloat(target)
+	if !fOk || !tOk {
+		return false
+	}
+	switch op {
+	case ">":
+		return fNum > tNum
+	case ">=":
+		return fNum >= tNum
+	case "<":
+		return fNum < tNum
+	case "<=":
+		return fNum <= tNum
+	}
+	return false
+}
+
+func queryValuesEqual(a, b interface{}) bool {
+	if aNum, ok := queryToFloat(a); ok {
+		if bNum, ok := queryToFloat(b); ok {
+			return aNum == bNum
+		}
+	}
+	return fmt.Sprintf("%v", a) == fmt.Sprintf("%v", b)
+}
+
+func queryToFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	}
+	return 0, false
+}
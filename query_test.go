@@ -0,0 +1,70 @@
+package chillson
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	sampleJSON := `{
+		"gophers": [
+			{"name": "joe", "weight": 8},
+			{"name": "mary", "weight": 12},
+			{"name": "sue", "weight": 20}
+		],
+		"nested": {"gophers": [{"name": "inner"}]}
+	}`
+	var data interface{}
+	err := json.Unmarshal([]byte(sampleJSON), &data)
+	if err != nil {
+		t.Errorf(err.Error())
+		return
+	}
+	chill := Son{data}
+
+	names, err := chill.Query("[gophers][*][name]")
+	if err != nil {
+		t.Errorf("Query wildcard returned an unexpected error: %v", err)
+	}
+	if len(names) != 3 || names[0].Value != "joe" || names[0].Path != "[gophers][0][name]" {
+		t.Errorf("Query([gophers][*][name]) returned unexpected results: %+v", names)
+	}
+
+	heavy, err := chill.Query("[gophers][?(@.weight>10)]")
+	if err != nil {
+		t.Errorf("Query filter returned an unexpected error: %v", err)
+	}
+	if len(heavy) != 2 {
+		t.Errorf("Query filter [?(@.weight>10)] should match 2 gophers, got %d", len(heavy))
+	}
+
+	named, err := chill.Query(`[gophers][?(@.name=="sue")]`)
+	if err != nil {
+		t.Errorf("Query string filter returned an unexpected error: %v", err)
+	}
+	if len(named) != 1 || named[0].Path != "[gophers][2]" {
+		t.Errorf("Query filter [?(@.name==\"sue\")] returned unexpected results: %+v", named)
+	}
+
+	sliced, err := chill.Query("[gophers][0:2]")
+	if err != nil {
+		t.Errorf("Query slice returned an unexpected error: %v", err)
+	}
+	if len(sliced) != 2 || sliced[1].Path != "[gophers][1]" {
+		t.Errorf("Query slice [0:2] returned unexpected results: %+v", sliced)
+	}
+
+	recursive, err := chill.Query("..name")
+	if err != nil {
+		t.Errorf("Query recursive descent returned an unexpected error: %v", err)
+	}
+	if len(recursive) != 4 {
+		t.Errorf("Query ..name should find 4 name fields across the whole document, got %d", len(recursive))
+	}
+
+	for _, r := range heavy {
+		if _, err := chill.Get(r.Path); err != nil {
+			t.Errorf("Result path %q doesn't round-trip through Get: %v", r.Path, err)
+		}
+	}
+}
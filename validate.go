@@ -0,0 +1,95 @@
+package chillson
+
+import (
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+/* ValidationError describes a single JSON Schema violation. Path is a chillson-style bracket path
+(e.g. "[gophers][0][weight]") pointing at the offending value, so it can be fed straight back into
+Get/Require; Keyword is gojsonschema's name for the check that failed, which is the schema keyword
+for some checks (e.g. "required") but not for all of them: a failed "type" reports "invalid_type"
+and a failed "minimum" reports "number_gte". */
+type ValidationError struct {
+	Path        string
+	Keyword     string
+	Description string
+}
+
+/* ValidationResult wraps the outcome of validating a Son against a JSON Schema document. */
+type ValidationResult struct {
+	valid  bool
+	errors []ValidationError
+}
+
+func (r *ValidationResult) Valid() bool {
+	return r.valid
+}
+
+func (r *ValidationResult) Errors() []ValidationError {
+	return r.errors
+}
+
+/* bracketPathFromSchemaField converts the dotted field path gojsonschema reports (e.g.
+"(root).gophers.0.weight") into chillson's own "[gophers][0][weight]" bracket form. */
+func bracketPathFromSchemaField(field string) string {
+	field = strings.TrimPrefix(field, "(root)")
+	field = strings.TrimPrefix(field, ".")
+	if field == "" {
+		return ""
+	}
+	var path strings.Builder
+	for _, label := range strings.Split(field, ".") {
+		path.WriteString("[")
+		path.WriteString(label)
+		path.WriteString("]")
+	}
+	return path.String()
+}
+
+/* Validate runs Data against schema (JSON Schema draft 4, 6 or 7) and reports every failing
+location as a chillson-style bracket path, so a failure can be fed straight back into
+Get/Require. The returned error is non-nil only when schema itself is malformed or cannot be
+loaded; a Data that doesn't conform to an otherwise-valid schema is reported through
+ValidationResult instead. */
+func (c *Son) Validate(schema []byte) (*ValidationResult, error) {
+	schemaLoader := gojsonschema.NewBytesLoader(schema)
+	docLoader := gojsonschema.NewGoLoader((*c).Data)
+	result, err := gojsonschema.Validate(schemaLoader, docLoader)
+	if err != nil {
+		return nil, err
+	}
+	errs := make([]ValidationError, len(result.Errors()))
+	for i, e := range result.Errors() {
+		errs[i] = ValidationError{
+			Path:        bracketPathFromSchemaField(e.Field()),
+			Keyword:     e.Type(),
+			Description: e.Description(),
+		}
+	}
+	return &ValidationResult{valid: result.Valid(), errors: errs}, nil
+}
+
+/* MustValidate behaves like Validate, but panics instead of returning a second error value; use
+it the same way as the Require* family, when a malformed schema is a programming error rather than
+something a caller should handle. */
+func (c *Son) MustValidate(schema []byte) *ValidationResult {
+	result, err := c.Validate(schema)
+	if err != nil {
+		panic(err.Error())
+	}
+	return result
+}
+
+/* ValidateAt validates only the sub-document found at path against schema, which is the natural
+way to check a document against a schema fragment extracted from a larger spec, such as an OpenAPI
+components/schemas entry. */
+func (c *Son) ValidateAt(path string, schema []byte) (*ValidationResult, error) {
+	val, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	sub := Son{val}
+	return sub.Validate(schema)
+}
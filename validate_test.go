@@ -0,0 +1,69 @@
+package chillson
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	sampleJSON := "{\"gophers\":[{\"name\":\"joe\",\"weight\":12}]}"
+	var data interface{}
+	err := json.Unmarshal([]byte(sampleJSON), &data)
+	if err != nil {
+		t.Errorf(err.Error())
+		return
+	}
+	chill := Son{data}
+	schema := []byte(`{
+		"type": "object",
+		"required": ["gophers"],
+		"properties": {
+			"gophers": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"required": ["name", "weight"],
+					"properties": {
+						"name": {"type": "string"},
+						"weight": {"type": "number", "minimum": 20}
+					}
+				}
+			}
+		}
+	}`)
+
+	result, err := chill.Validate(schema)
+	if err != nil {
+		t.Errorf("Validate returned an unexpected error: %v", err)
+		return
+	}
+	if result.Valid() {
+		t.Errorf("Validate should have failed the weight minimum constraint.")
+	}
+	found := false
+	for _, e := range result.Errors() {
+		if e.Path == "[gophers][0][weight]" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Validate didn't report the failing path as [gophers][0][weight] (%v).", result.Errors())
+	}
+
+	gopherSchema := []byte(`{
+		"type": "object",
+		"required": ["name", "weight"],
+		"properties": {
+			"name": {"type": "string"},
+			"weight": {"type": "number", "minimum": 20}
+		}
+	}`)
+	subResult, err := chill.ValidateAt("[gophers][0]", gopherSchema)
+	if err != nil {
+		t.Errorf("ValidateAt returned an unexpected error: %v", err)
+		return
+	}
+	if subResult.Valid() {
+		t.Errorf("ValidateAt should have failed the weight minimum constraint on the sub-document.")
+	}
+}
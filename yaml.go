@@ -0,0 +1,79 @@
+package chillson
+
+import (
+	yaml "gopkg.in/yaml.v2"
+)
+
+/* NewFromYAML parses YAML source into the same map[string]interface{}/[]interface{} shape Son
+already expects from encoding/json, so every existing Get.../Require... method and the mutation API
+work unchanged against YAML sources such as Kubernetes manifests, docker-compose files or GitHub
+Actions workflows. yaml.v2, like most Go YAML libraries, decodes mappings into
+map[interface{}]interface{}; NewFromYAML normalizes those recursively into
+map[string]interface{}, returning NonStringKey if a mapping ever has a non-string key. It also
+normalizes plain YAML integers, which yaml.v2 decodes as int/int64 rather than float64, to
+float64 - the same numeric type encoding/json produces - so GetFloat/GetInt/GetInt64 see the same
+leaf type regardless of source. */
+func NewFromYAML(data []byte) (*Son, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	normalized, err := normalizeYAMLValue(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &Son{normalized}, nil
+}
+
+func normalizeYAMLValue(value interface{}) (interface{}, error) {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		obj := make(map[string]interface{}, len(typed))
+		for rawKey, rawVal := range typed {
+			key, ok := rawKey.(string)
+			if !ok {
+				return nil, NonStringKey
+			}
+			normVal, err := normalizeYAMLValue(rawVal)
+			if err != nil {
+				return nil, err
+			}
+			obj[key] = normVal
+		}
+		return obj, nil
+	case map[string]interface{}:
+		obj := make(map[string]interface{}, len(typed))
+		for key, rawVal := range typed {
+			normVal, err := normalizeYAMLValue(rawVal)
+			if err != nil {
+				return nil, err
+			}
+			obj[key] = normVal
+		}
+		return obj, nil
+	case []interface{}:
+		arr := make([]interface{}, len(typed))
+		for i, rawVal := range typed {
+			normVal, err := normalizeYAMLValue(rawVal)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = normVal
+		}
+		return arr, nil
+	case int:
+		return float64(typed), nil
+	case int64:
+		return float64(typed), nil
+	case uint64:
+		return float64(typed), nil
+	default:
+		return typed, nil
+	}
+}
+
+/* MarshalYAML re-emits Data as YAML, the natural counterpart to NewFromYAML for a load/edit/save
+round trip through the mutation API. */
+func (c *Son) MarshalYAML() ([]byte, error) {
+	return yaml.Marshal((*c).Data)
+}
@@ -0,0 +1,66 @@
+package chillson
+
+import (
+	"testing"
+)
+
+func TestNewFromYAML(t *testing.T) {
+	sampleYAML := []byte(`
+foo: bar
+gophers:
+  - name: joe
+    weight: 12
+  - name: mary
+    weight: 8
+`)
+	chill, err := NewFromYAML(sampleYAML)
+	if err != nil {
+		t.Errorf("NewFromYAML returned an unexpected error: %v", err)
+		return
+	}
+	if _, ok := chill.Data.(map[string]interface{}); !ok {
+		t.Errorf("NewFromYAML's top-level Data isn't a map[string]interface{} (%T).", chill.Data)
+	}
+	s, err := chill.GetStr("[foo]")
+	if err != nil || s != "bar" {
+		t.Errorf("[foo] doesn't return \"bar\" (%v, %v).", s, err)
+	}
+	name, err := chill.GetStr("[gophers][0][name]")
+	if err != nil || name != "joe" {
+		t.Errorf("[gophers][0][name] doesn't return \"joe\" (%v, %v).", name, err)
+	}
+	weight, err := chill.GetInt("[gophers][0][weight]")
+	if err != nil || weight != 12 {
+		t.Errorf("[gophers][0][weight] doesn't return 12 (%v, %v).", weight, err)
+	}
+	if _, ok := chill.RequireArr("[gophers]")[0].(map[string]interface{}); !ok {
+		t.Errorf("Nested YAML mapping wasn't normalized to map[string]interface{}.")
+	}
+
+	if err := chill.Set("jill", "[gophers][1][name]"); err != nil {
+		t.Errorf("Set on YAML-sourced data failed: %v", err)
+	}
+	if v, err := chill.GetStr("[gophers][1][name]"); err != nil || v != "jill" {
+		t.Errorf("Set on YAML-sourced data didn't stick (%v, %v).", v, err)
+	}
+
+	out, err := chill.MarshalYAML()
+	if err != nil {
+		t.Errorf("MarshalYAML returned an unexpected error: %v", err)
+	}
+	roundTripped, err := NewFromYAML(out)
+	if err != nil {
+		t.Errorf("Re-parsing MarshalYAML's output failed: %v", err)
+		return
+	}
+	if v, err := roundTripped.GetStr("[gophers][1][name]"); err != nil || v != "jill" {
+		t.Errorf("YAML round trip lost the edited value (%v, %v).", v, err)
+	}
+}
+
+func TestNewFromYAMLNonStringKey(t *testing.T) {
+	sampleYAML := []byte("1: foo\n")
+	if _, err := NewFromYAML(sampleYAML); err != NonStringKey {
+		t.Errorf("NewFromYAML with a non-string key should return NonStringKey, got %v", err)
+	}
+}